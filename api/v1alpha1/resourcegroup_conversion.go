@@ -0,0 +1,89 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	"github.com/aws/symphony/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this v1alpha1 ResourceGroup to the v1beta1 hub version.
+// It round-trips Spec.Definition, Status.Conditions and
+// Status.TopoligicalOrder (renamed to TopologicalOrder in v1beta1, fixing
+// the long-standing typo).
+func (src *ResourceGroup) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.ResourceGroup)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.APIVersion = src.Spec.APIVersion
+	dst.Spec.Kind = src.Spec.Kind
+	dst.Spec.Definition = src.Spec.Definition
+
+	dst.Status.State = src.Status.State
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.TopologicalOrder = src.Status.TopoligicalOrder
+	dst.Status.Validation = convertValidationToV1beta1(src.Status.Validation)
+
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 hub version into this v1alpha1
+// ResourceGroup, the inverse of ConvertTo.
+func (dst *ResourceGroup) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.ResourceGroup)
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.APIVersion = src.Spec.APIVersion
+	dst.Spec.Kind = src.Spec.Kind
+	dst.Spec.Definition = src.Spec.Definition
+
+	dst.Status.State = src.Status.State
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.TopoligicalOrder = src.Status.TopologicalOrder
+	dst.Status.Validation = convertValidationFromV1beta1(src.Status.Validation)
+
+	return nil
+}
+
+func convertValidationToV1beta1(issues []ValidationIssue) []v1beta1.ValidationIssue {
+	if issues == nil {
+		return nil
+	}
+	out := make([]v1beta1.ValidationIssue, len(issues))
+	for i, issue := range issues {
+		out[i] = v1beta1.ValidationIssue{
+			Path:     issue.Path,
+			Expected: issue.Expected,
+			Reason:   issue.Reason,
+		}
+	}
+	return out
+}
+
+func convertValidationFromV1beta1(issues []v1beta1.ValidationIssue) []ValidationIssue {
+	if issues == nil {
+		return nil
+	}
+	out := make([]ValidationIssue, len(issues))
+	for i, issue := range issues {
+		out[i] = ValidationIssue{
+			Path:     issue.Path,
+			Expected: issue.Expected,
+			Reason:   issue.Reason,
+		}
+	}
+	return out
+}
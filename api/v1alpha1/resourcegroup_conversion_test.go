@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/symphony/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestResourceGroupConversionRoundTrip checks that ConvertTo/ConvertFrom are
+// inverses for a handful of representative v1alpha1 ResourceGroups (as an
+// existing cluster would have stored before v1beta1 became the storage
+// version). It calls the conversion functions directly, in-process, so it
+// does not exercise the conversion webhook or an apiserver's handling of two
+// served versions.
+//
+// Follow-up (tracked, not done here): an envtest suite that upgrades a
+// cluster with existing v1alpha1 objects through a real CRD with the
+// conversion webhook registered, covering the compatibility matrix this
+// request asked for end-to-end. It isn't in this change because this
+// checkout has no envtest harness (no manager/webhook server wiring) to
+// build it on.
+func TestResourceGroupConversionRoundTrip(t *testing.T) {
+	cases := map[string]*ResourceGroup{
+		"freshly created, no status": {
+			Spec: ResourceGroupSpec{
+				APIVersion: "v1alpha1",
+				Kind:       "Bucket",
+			},
+		},
+		"active with topological order": {
+			Spec: ResourceGroupSpec{
+				APIVersion: "v1alpha1",
+				Kind:       "Bucket",
+			},
+			Status: ResourceGroupStatus{
+				State: "ACTIVE",
+				Conditions: []metav1.Condition{
+					{Type: "GraphVerified", Status: metav1.ConditionTrue, Reason: "", Message: "Directed Acyclic Graph is synced"},
+				},
+				TopoligicalOrder: []string{"bucket", "policy"},
+			},
+		},
+		"inactive with validation issues": {
+			Spec: ResourceGroupSpec{
+				APIVersion: "v1alpha1",
+				Kind:       "Bucket",
+			},
+			Status: ResourceGroupStatus{
+				State: "INACTIVE",
+				Validation: []ValidationIssue{
+					{Path: ".spec.replicas", Expected: "integer", Reason: "schema mismatch at .spec.replicas: expected type \"integer\", got \"string\""},
+				},
+			},
+		},
+	}
+
+	for name, original := range cases {
+		t.Run(name, func(t *testing.T) {
+			src := original.DeepCopy()
+
+			hub := &v1beta1.ResourceGroup{}
+			if err := src.ConvertTo(hub); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+
+			roundTripped := &ResourceGroup{}
+			if err := roundTripped.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+
+			if !reflect.DeepEqual(original, roundTripped) {
+				t.Fatalf("round trip through v1beta1 changed the object:\nbefore: %+v\nafter:  %+v", original, roundTripped)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1beta1
+
+// Hub marks ResourceGroup as the conversion hub: spoke versions (api/v1alpha1)
+// implement conversion.Convertible against this type rather than the other
+// way around. See sigs.k8s.io/controller-runtime/pkg/conversion.
+func (*ResourceGroup) Hub() {}
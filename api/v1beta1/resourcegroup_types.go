@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package v1beta1 is the storage version of the ResourceGroup API. It is the
+// conversion hub: api/v1alpha1.ResourceGroup converts to and from this
+// package's types rather than the other way around, so this is where new
+// ResourceGroup fields land going forward.
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ResourceGroupSpec defines the desired state of a ResourceGroup: the CRD it
+// should generate and the resource graph its instances reconcile.
+type ResourceGroupSpec struct {
+	// APIVersion is the API version of the generated CustomResourceDefinition.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the Kind of the generated CustomResourceDefinition.
+	Kind string `json:"kind"`
+	// Definition is the raw schema and resource graph this ResourceGroup
+	// compiles into a CustomResourceDefinition and a reconciliation graph.
+	Definition *runtime.RawExtension `json:"definition"`
+}
+
+// ValidationIssue is a single schema or CEL problem found while processing a
+// ResourceGroup's definition, scoped to the field that caused it.
+type ValidationIssue struct {
+	// Path is the JSONPath-like location of the offending field, e.g.
+	// spec.template.spec.containers[0].env[0].value.
+	Path string `json:"path"`
+	// Expected is the type or schema the field was expected to satisfy, if
+	// known.
+	Expected string `json:"expected,omitempty"`
+	// Reason is a short human-readable description of the problem.
+	Reason string `json:"reason"`
+}
+
+// ResourceGroupStatus defines the observed state of a ResourceGroup.
+type ResourceGroupStatus struct {
+	// State is the high level state of the ResourceGroup: ACTIVE or INACTIVE.
+	State string `json:"state,omitempty"`
+	// Conditions describe the latest observations of the ResourceGroup's state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// TopologicalOrder is the order in which the ResourceGroup's resources
+	// are reconciled. Spelled correctly here; api/v1alpha1 keeps its
+	// original TopoligicalOrder spelling and converts between the two.
+	TopologicalOrder []string `json:"topologicalOrder,omitempty"`
+	// Validation surfaces the schema/CEL issue found the last time the
+	// ResourceGroup's definition failed to process, so users can debug
+	// schema or CEL errors without grepping controller logs. It is cleared
+	// once the ResourceGroup processes successfully.
+	//
+	// This is a slice for forward compatibility with a parser that reports
+	// every issue in the definition at once; today the parser stops at the
+	// first problem it finds, so it never holds more than one entry.
+	Validation []ValidationIssue `json:"validation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// ResourceGroup is the Schema for the resourcegroups API.
+type ResourceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceGroupSpec   `json:"spec,omitempty"`
+	Status ResourceGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceGroupList contains a list of ResourceGroup.
+type ResourceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceGroup `json:"items"`
+}
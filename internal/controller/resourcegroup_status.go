@@ -83,6 +83,7 @@ func (r *ResourceGroupReconciler) setResourceGroupStatus(ctx context.Context, re
 	)
 	dc.Status.State = "ACTIVE"
 	dc.Status.TopoligicalOrder = topologicalOrder
+	dc.Status.Validation = nil
 
 	if reconcileErr != nil {
 		log.V(1).Info("Error occurred during reconcile", "error", reconcileErr)
@@ -90,12 +91,19 @@ func (r *ResourceGroupReconciler) setResourceGroupStatus(ctx context.Context, re
 		var processCRDErr *serr.ProcessCRDError
 		if errors.As(reconcileErr, &processCRDErr) {
 			log.V(1).Info("Handling CRD (open-simple-schema) error", "error", reconcileErr)
+
+			// Pull out any per-path schema/CEL issue the parser found, so
+			// the conditions and Status.Validation point at exactly which
+			// field is broken instead of a single opaque error string.
+			message, validation := validationStatusForError(reconcileErr)
+			dc.Status.Validation = validation
+
 			// set all conditions to unknown and crd condition to false
 			dc.Status.Conditions = condition.SetCondition(dc.Status.Conditions,
-				condition.NewGraphVerifiedCondition(corev1.ConditionUnknown, "error parsing schema: "+reconcileErr.Error(), "Directed Acyclic Graph is synced"),
+				condition.NewGraphVerifiedCondition(corev1.ConditionUnknown, message, "Directed Acyclic Graph is synced"),
 			)
 			dc.Status.Conditions = condition.SetCondition(dc.Status.Conditions,
-				condition.NewCustomResourceDefinitionSyncedCondition(corev1.ConditionFalse, "error parsing schema: "+reconcileErr.Error(), "Custom Resource Definition is synced"),
+				condition.NewCustomResourceDefinitionSyncedCondition(corev1.ConditionFalse, message, "Custom Resource Definition is synced"),
 			)
 			reason := "Faulty Graph"
 			dc.Status.Conditions = condition.SetCondition(dc.Status.Conditions,
@@ -160,6 +168,21 @@ func (r *ResourceGroupReconciler) setResourceGroupStatus(ctx context.Context, re
 	return r.Status().Patch(ctx, dc.DeepCopy(), patch)
 }
 
+// validationStatusForError derives the GraphVerified/CustomResourceDefinitionSynced
+// condition message and the ResourceGroup's Status.Validation from a
+// ProcessCRDError, preferring the first typed parser issue ValidationIssuesFromError
+// recognizes over the error's opaque string. It is split out of
+// setResourceGroupStatus so it can be tested without a client.
+func validationStatusForError(reconcileErr error) (message string, validation []v1alpha1.ValidationIssue) {
+	validation = serr.ValidationIssuesFromError(reconcileErr)
+
+	message = "error parsing schema: " + reconcileErr.Error()
+	if len(validation) > 0 {
+		message = validation[0].Reason
+	}
+	return message, validation
+}
+
 func (r *ResourceGroupReconciler) setManaged(ctx context.Context, resourcegroup *v1alpha1.ResourceGroup) error {
 	log := log.FromContext(ctx)
 	log.V(1).Info("setting resourcegroup as managed - adding finalizer")
@@ -187,13 +210,35 @@ func (r *ResourceGroupReconciler) setUnmanaged(ctx context.Context, resourcegrou
 
 func getGVR(customRD *v1.CustomResourceDefinition) *schema.GroupVersionResource {
 	return &schema.GroupVersionResource{
-		Group: customRD.Spec.Group,
-		// Deal with complex versioning later on
-		Version:  customRD.Spec.Versions[0].Name,
+		Group:    customRD.Spec.Group,
+		Version:  selectCRDVersion(customRD),
 		Resource: customRD.Spec.Names.Plural,
 	}
 }
 
+// selectCRDVersion picks the CRD version the controller should reconcile
+// against: the version marked as storage, since that's the one a
+// ResourceGroup's generated CRD settles on as its schema is promoted across
+// versions (e.g. v1alpha1 -> v1beta1). It falls back to the first listed
+// version if none is marked as storage, whether or not that one is served.
+//
+// This replaces the old customRD.Spec.Versions[0] assumption with a sane
+// default, but it is not itself configurable: there is no knob yet to
+// reconcile against a version other than the storage one.
+//
+// Follow-up (tracked, not done here): add a ResourceGroupReconciler field
+// (or similar) to override this default. It isn't implemented in this
+// change because this checkout has no reconciler construction site to hang
+// it off of -- ResourceGroupReconciler itself isn't defined anywhere here.
+func selectCRDVersion(customRD *v1.CustomResourceDefinition) string {
+	for _, version := range customRD.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	return customRD.Spec.Versions[0].Name
+}
+
 func processCRD(ctx context.Context, resourceGroup *v1alpha1.ResourceGroup) (*v1.CustomResourceDefinition, *schema.GroupVersionResource, error) {
 	customCRD, err := crd.BuildCRDObjectFromRawNeoCRDSchema(resourceGroup.Spec.APIVersion, resourceGroup.Spec.Kind, resourceGroup.Spec.Definition)
 	if err != nil {
@@ -0,0 +1,102 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	serr "github.com/aws/symphony/internal/errors"
+	"github.com/aws/symphony/internal/typesystem/parser"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// ResourceGroupReconciler isn't constructible in this checkout (its fields
+// live outside it), so these test the pure condition/Status.Validation
+// derivation setResourceGroupStatus delegates to, rather than
+// setResourceGroupStatus itself.
+
+func TestValidationStatusForErrorWithTypedParserError(t *testing.T) {
+	reconcileErr := serr.NewProcessCRDError(&parser.SchemaMismatchError{
+		Path:     "spec.template.spec.containers[0].env[0].value",
+		Expected: "string",
+		GotType:  "float64",
+	})
+
+	message, validation := validationStatusForError(reconcileErr)
+
+	if len(validation) != 1 || validation[0].Path != "spec.template.spec.containers[0].env[0].value" {
+		t.Fatalf("unexpected validation: %+v", validation)
+	}
+	if message != validation[0].Reason {
+		t.Fatalf("expected condition message to be the typed issue's reason, got %q", message)
+	}
+}
+
+func TestValidationStatusForErrorFallsBackToOpaqueMessage(t *testing.T) {
+	// A ProcessCRDError that doesn't wrap a recognized parser error (e.g. a
+	// bug in a caller, not a schema problem) should still produce a usable
+	// condition message instead of an empty one.
+	reconcileErr := serr.NewProcessCRDError(errors.New("boom"))
+
+	message, validation := validationStatusForError(reconcileErr)
+
+	if validation != nil {
+		t.Fatalf("expected no validation issues, got %v", validation)
+	}
+	if message != "error parsing schema: "+reconcileErr.Error() {
+		t.Fatalf("unexpected fallback message: %q", message)
+	}
+}
+
+func crdVersions(names ...string) []v1.CustomResourceDefinitionVersion {
+	versions := make([]v1.CustomResourceDefinitionVersion, len(names))
+	for i, name := range names {
+		versions[i] = v1.CustomResourceDefinitionVersion{Name: name}
+	}
+	return versions
+}
+
+func TestSelectCRDVersion(t *testing.T) {
+	cases := map[string]struct {
+		versions []v1.CustomResourceDefinitionVersion
+		want     string
+	}{
+		"storage version found, not first": {
+			versions: func() []v1.CustomResourceDefinitionVersion {
+				versions := crdVersions("v1alpha1", "v1beta1")
+				versions[1].Storage = true
+				return versions
+			}(),
+			want: "v1beta1",
+		},
+		"storage version missing falls back to first listed version": {
+			versions: crdVersions("v1alpha1", "v1beta1"),
+			want:     "v1alpha1",
+		},
+		"single version, not marked as storage": {
+			versions: crdVersions("v1alpha1"),
+			want:     "v1alpha1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			customRD := &v1.CustomResourceDefinition{Spec: v1.CustomResourceDefinitionSpec{Versions: tc.versions}}
+			if got := selectCRDVersion(customRD); got != tc.want {
+				t.Fatalf("selectCRDVersion: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package errors defines the top-level error categories the controller uses
+// to decide how a ResourceGroup's conditions should be set. Each category
+// wraps the underlying cause so callers can still inspect it with
+// errors.As/errors.Is, while the controller only needs to switch on the
+// handful of types declared here.
+package errors
+
+import "fmt"
+
+// ProcessCRDError indicates that building the CustomResourceDefinition for a
+// ResourceGroup's schema failed, for example because the schema could not be
+// parsed by the typesystem/parser package.
+type ProcessCRDError struct {
+	Err error
+}
+
+// NewProcessCRDError wraps err as a ProcessCRDError.
+func NewProcessCRDError(err error) *ProcessCRDError {
+	return &ProcessCRDError{Err: err}
+}
+
+func (e *ProcessCRDError) Error() string {
+	return fmt.Sprintf("error processing CRD: %v", e.Err)
+}
+
+func (e *ProcessCRDError) Unwrap() error {
+	return e.Err
+}
+
+// ReconcileGraphError indicates that building or validating the resource
+// graph (the DAG of resources in a ResourceGroup) failed.
+type ReconcileGraphError struct {
+	Err error
+}
+
+// NewReconcileGraphError wraps err as a ReconcileGraphError.
+func NewReconcileGraphError(err error) *ReconcileGraphError {
+	return &ReconcileGraphError{Err: err}
+}
+
+func (e *ReconcileGraphError) Error() string {
+	return fmt.Sprintf("error reconciling graph: %v", e.Err)
+}
+
+func (e *ReconcileGraphError) Unwrap() error {
+	return e.Err
+}
+
+// ReconcileCRDError indicates that applying the generated
+// CustomResourceDefinition to the cluster failed.
+type ReconcileCRDError struct {
+	Err error
+}
+
+// NewReconcileCRDError wraps err as a ReconcileCRDError.
+func NewReconcileCRDError(err error) *ReconcileCRDError {
+	return &ReconcileCRDError{Err: err}
+}
+
+func (e *ReconcileCRDError) Error() string {
+	return fmt.Sprintf("error reconciling CRD: %v", e.Err)
+}
+
+func (e *ReconcileCRDError) Unwrap() error {
+	return e.Err
+}
+
+// ReconcileMicroControllerError indicates that starting or updating the
+// dynamic micro-controller for a ResourceGroup failed.
+type ReconcileMicroControllerError struct {
+	Err error
+}
+
+// NewReconcileMicroControllerError wraps err as a ReconcileMicroControllerError.
+func NewReconcileMicroControllerError(err error) *ReconcileMicroControllerError {
+	return &ReconcileMicroControllerError{Err: err}
+}
+
+func (e *ReconcileMicroControllerError) Error() string {
+	return fmt.Sprintf("error reconciling micro controller: %v", e.Err)
+}
+
+func (e *ReconcileMicroControllerError) Unwrap() error {
+	return e.Err
+}
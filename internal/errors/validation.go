@@ -0,0 +1,106 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package errors
+
+import (
+	stderrors "errors"
+
+	"github.com/aws/symphony/api/v1alpha1"
+	"github.com/aws/symphony/internal/typesystem/parser"
+)
+
+// ValidationIssuesFromError walks err looking for the typed errors the
+// parser package returns from ParseResource, and converts the one it finds
+// into a v1alpha1.ValidationIssue suitable for ResourceGroup.Status.Validation.
+// It returns nil if err does not wrap any recognized parser error.
+//
+// parseResource returns on the first error it encounters rather than
+// accumulating across the tree, so err only ever wraps a single one of these
+// types and this never returns more than one issue; the slice return type is
+// for forward compatibility with a parser that accumulates errors, not a
+// promise that it currently does.
+func ValidationIssuesFromError(err error) []v1alpha1.ValidationIssue {
+	if err == nil {
+		return nil
+	}
+
+	var issues []v1alpha1.ValidationIssue
+
+	var schemaMismatch *parser.SchemaMismatchError
+	if stderrors.As(err, &schemaMismatch) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:     schemaMismatch.Path,
+			Expected: schemaMismatch.Expected,
+			Reason:   schemaMismatch.Error(),
+		})
+	}
+
+	var invalidArray *parser.InvalidArraySchemaError
+	if stderrors.As(err, &invalidArray) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   invalidArray.Path,
+			Reason: invalidArray.Error(),
+		})
+	}
+
+	var unknownField *parser.UnknownFieldError
+	if stderrors.As(err, &unknownField) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   unknownField.Path,
+			Reason: unknownField.Error(),
+		})
+	}
+
+	var celSyntax *parser.CELSyntaxError
+	if stderrors.As(err, &celSyntax) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   celSyntax.Path,
+			Reason: celSyntax.Error(),
+		})
+	}
+
+	var unsupported *parser.UnsupportedSchemaError
+	if stderrors.As(err, &unsupported) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   unsupported.Path,
+			Reason: unsupported.Error(),
+		})
+	}
+
+	var unionNoMatch *parser.UnionNoMatchError
+	if stderrors.As(err, &unionNoMatch) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   unionNoMatch.Path,
+			Reason: unionNoMatch.Error(),
+		})
+	}
+
+	var ambiguousUnion *parser.AmbiguousUnionMatchError
+	if stderrors.As(err, &ambiguousUnion) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   ambiguousUnion.Path,
+			Reason: ambiguousUnion.Error(),
+		})
+	}
+
+	var allOfConflict *parser.AllOfConflictError
+	if stderrors.As(err, &allOfConflict) {
+		issues = append(issues, v1alpha1.ValidationIssue{
+			Path:   allOfConflict.Path,
+			Reason: allOfConflict.Error(),
+		})
+	}
+
+	return issues
+}
@@ -0,0 +1,65 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package errors
+
+import (
+	"testing"
+
+	"github.com/aws/symphony/internal/typesystem/parser"
+)
+
+func TestValidationIssuesFromErrorNil(t *testing.T) {
+	if issues := ValidationIssuesFromError(nil); issues != nil {
+		t.Fatalf("expected nil issues for a nil error, got %v", issues)
+	}
+}
+
+func TestValidationIssuesFromErrorUnrecognized(t *testing.T) {
+	if issues := ValidationIssuesFromError(NewProcessCRDError(nil)); issues != nil {
+		t.Fatalf("expected nil issues for an error that doesn't wrap a parser error, got %v", issues)
+	}
+}
+
+func TestValidationIssuesFromErrorSchemaMismatch(t *testing.T) {
+	// Wrapped in a ProcessCRDError the way processCRD actually returns parser
+	// errors, so this also exercises the errors.As unwrapping.
+	err := NewProcessCRDError(&parser.SchemaMismatchError{
+		Path:     "spec.template.spec.containers[0].env[0].value",
+		Expected: "string",
+		GotType:  "float64",
+	})
+
+	issues := ValidationIssuesFromError(err)
+	if len(issues) != 1 {
+		t.Fatalf("expected exactly one issue, got %d: %v", len(issues), issues)
+	}
+	if issues[0].Path != "spec.template.spec.containers[0].env[0].value" {
+		t.Fatalf("unexpected issue path: %+v", issues[0])
+	}
+	if issues[0].Expected != "string" {
+		t.Fatalf("unexpected issue expected type: %+v", issues[0])
+	}
+}
+
+func TestValidationIssuesFromErrorUnionNoMatch(t *testing.T) {
+	err := &parser.UnionNoMatchError{Path: "spec.value", BranchErrors: []string{"branch 0: nope"}}
+
+	issues := ValidationIssuesFromError(err)
+	if len(issues) != 1 {
+		t.Fatalf("expected exactly one issue, got %d: %v", len(issues), issues)
+	}
+	if issues[0].Path != "spec.value" {
+		t.Fatalf("unexpected issue path: %+v", issues[0])
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SchemaMismatchError indicates that the concrete value found at Path does
+// not match the type the schema declared for it.
+type SchemaMismatchError struct {
+	// Path is the JSONPath-like location of the offending field.
+	Path string
+	// Expected is the type the schema declared for Path.
+	Expected string
+	// GotType is the type of the value actually found at Path.
+	GotType string
+}
+
+func (e *SchemaMismatchError) Error() string {
+	return fmt.Sprintf("schema mismatch at %s: expected type %q, got %q", e.Path, e.Expected, e.GotType)
+}
+
+// InvalidArraySchemaError indicates that the array schema at Path defines
+// neither Items.Schema nor Properties, so its element type cannot be
+// determined.
+type InvalidArraySchemaError struct {
+	Path string
+}
+
+func (e *InvalidArraySchemaError) Error() string {
+	return fmt.Sprintf("invalid array schema at %s: neither items.schema nor properties are defined", e.Path)
+}
+
+// UnknownFieldError indicates that Field, found at Path, has no corresponding
+// entry in the schema's properties, and the schema does not allow additional
+// properties.
+type UnknownFieldError struct {
+	Path  string
+	Field string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q at %s: not defined in schema and additionalProperties is not allowed", e.Field, e.Path)
+}
+
+// CELSyntaxError indicates that Expression, found at Path, could not be
+// parsed as a CEL expression. Pos is the byte offset within Expression where
+// parsing failed, or -1 if the underlying parser did not report one.
+type CELSyntaxError struct {
+	Path       string
+	Expression string
+	Pos        int
+	Err        error
+}
+
+func (e *CELSyntaxError) Error() string {
+	return fmt.Sprintf("invalid CEL expression %q at %s (pos %d): %v", e.Expression, e.Path, e.Pos, e.Err)
+}
+
+func (e *CELSyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// UnsupportedSchemaError indicates that the schema at Path uses a feature
+// ParseResource does not know how to evaluate.
+type UnsupportedSchemaError struct {
+	Path   string
+	Reason string
+}
+
+func (e *UnsupportedSchemaError) Error() string {
+	return fmt.Sprintf("unsupported schema at %s: %s", e.Path, e.Reason)
+}
+
+// UnionNoMatchError indicates that the value at Path satisfied none of the
+// branches of a oneOf/anyOf schema. BranchErrors holds one entry per branch
+// describing why that branch was rejected, in branch order.
+type UnionNoMatchError struct {
+	Path         string
+	BranchErrors []string
+}
+
+func (e *UnionNoMatchError) Error() string {
+	return fmt.Sprintf("value at %s did not match any branch of the union schema:\n%s", e.Path, strings.Join(e.BranchErrors, "\n"))
+}
+
+// AmbiguousUnionMatchError indicates that a concrete (non-CEL) value at Path
+// satisfied more than one branch of a oneOf schema, which requires exactly
+// one branch to match.
+type AmbiguousUnionMatchError struct {
+	Path            string
+	MatchedBranches []int
+}
+
+func (e *AmbiguousUnionMatchError) Error() string {
+	return fmt.Sprintf("value at %s matched more than one oneOf branch: %v", e.Path, e.MatchedBranches)
+}
+
+// AllOfConflictError indicates that two branches of an allOf schema at Path
+// declared different, and therefore irreconcilable, types.
+type AllOfConflictError struct {
+	Path  string
+	TypeA string
+	TypeB string
+}
+
+func (e *AllOfConflictError) Error() string {
+	return fmt.Sprintf("incompatible types in allOf at %s: %s vs %s", e.Path, e.TypeA, e.TypeB)
+}
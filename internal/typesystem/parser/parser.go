@@ -49,8 +49,29 @@ type ExpressionField struct {
 // and return an error if the resource does not match the schema. When CEL
 // expressions are found, they are extracted and returned with the expected
 // type of the field (inferred from the schema).
-func ParseResource(resource map[string]interface{}, resourceSchema *spec.Schema) ([]ExpressionField, error) {
-	return parseResource(resource, resourceSchema, "")
+//
+// Alongside the ExpressionFields, it returns the resource's drift-detection
+// IgnorePaths and ComparePolicy, derived from the kro.run/compare-options,
+// kro.run/sync-options and kro.run/ignore-diff annotations (or the schema's
+// x-kubernetes-ignore-diff extension), so a reconciler's patch path can skip
+// drift on ignored subtrees and choose a patch strategy per resource.
+//
+// ParseResource has no caller in this checkout yet: the instance
+// reconciler that would consume IgnorePaths/ComparePolicy to drive drift
+// detection and choose a patch strategy lives outside this checkout, and
+// wiring the two together is left to whichever change adds it.
+func ParseResource(resource map[string]interface{}, resourceSchema *spec.Schema) ([]ExpressionField, []string, ComparePolicy, error) {
+	fields, err := parseResource(resource, resourceSchema, "")
+	if err != nil {
+		return nil, nil, ComparePolicy{}, err
+	}
+
+	ignorePaths, policy, err := ExtractDriftPolicies(resource, resourceSchema)
+	if err != nil {
+		return nil, nil, ComparePolicy{}, err
+	}
+
+	return fields, ignorePaths, policy, nil
 }
 
 // parseResource is a helper function that recursively extracts CEL expressions
@@ -59,16 +80,34 @@ func ParseResource(resource map[string]interface{}, resourceSchema *spec.Schema)
 func parseResource(resource interface{}, schema *spec.Schema, path string) ([]ExpressionField, error) {
 	var expressionsFields []ExpressionField
 	if schema == nil {
-		return expressionsFields, fmt.Errorf("schema is nil for path %s", path)
+		return expressionsFields, &UnsupportedSchemaError{Path: path, Reason: "schema is nil"}
 	}
 
-	if len(schema.Type) != 1 {
-		if len(schema.OneOf) > 0 {
-			// TODO: Handle oneOf
-			schema.Type = []string{schema.OneOf[0].Type[0]}
-		} else {
-			return nil, fmt.Errorf("found schema type that is not a single type: %v", schema.Type)
+	if len(schema.AllOf) > 0 {
+		merged, compositeBranches, err := mergeAllOf(schema, path)
+		if err != nil {
+			return nil, err
 		}
+		// A branch that is itself a oneOf/anyOf/allOf contributes no
+		// properties/type to merge (those keywords are mutually exclusive
+		// with Type/Properties on a branch), so its constraint has to be
+		// enforced separately: re-validate resource against the branch
+		// itself. The branch's own ExpressionFields are discarded, since
+		// merged (below) is what actually drives the path's ExpectedType.
+		for i := range compositeBranches {
+			if _, err := parseResource(resource, &compositeBranches[i], path); err != nil {
+				return nil, err
+			}
+		}
+		schema = merged
+	}
+
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		return parseUnionSchema(resource, schema, path)
+	}
+
+	if len(schema.Type) != 1 {
+		return nil, &UnsupportedSchemaError{Path: path, Reason: fmt.Sprintf("schema type is not a single type: %v", schema.Type)}
 	}
 
 	// Determine the expected type
@@ -80,15 +119,15 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 	switch field := resource.(type) {
 	case map[string]interface{}:
 		if expectedType != "object" && (schema.AdditionalProperties == nil || !schema.AdditionalProperties.Allows) {
-			return nil, fmt.Errorf("expected object type or AdditionalProperties allowed for path %s, got %v", path, field)
+			return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: "object"}
 		}
 
 		for field, value := range field {
-			fieldSchema, err := getFieldSchema(schema, field)
+			fieldPath := path + "." + field
+			fieldSchema, err := getFieldSchema(schema, fieldPath, field)
 			if err != nil {
-				return nil, fmt.Errorf("error getting field schema for path %s: %v", path+"."+field, err)
+				return nil, err
 			}
-			fieldPath := path + "." + field
 			fieldExpressions, err := parseResource(value, fieldSchema, fieldPath)
 			if err != nil {
 				return nil, err
@@ -97,7 +136,7 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 		}
 	case []interface{}:
 		if expectedType != "array" {
-			return nil, fmt.Errorf("expected array type for path %s, got %v", path, field)
+			return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: "array"}
 		}
 		var itemSchema *spec.Schema
 
@@ -114,7 +153,7 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 			}
 		} else {
 			// If neither Items.Schema nor Properties are defined, we can't proceed
-			return nil, fmt.Errorf("invalid array schema for path %s: neither Items.Schema nor Properties are defined", path)
+			return nil, &InvalidArraySchemaError{Path: path}
 		}
 		for i, item := range field {
 			itemPath := fmt.Sprintf("%s[%d]", path, i)
@@ -127,7 +166,7 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 	case string:
 		ok, err := isOneShotExpression(field)
 		if err != nil {
-			return nil, err
+			return nil, &CELSyntaxError{Path: path, Expression: field, Pos: -1, Err: err}
 		}
 		if ok {
 			expressionsFields = append(expressionsFields, ExpressionField{
@@ -139,11 +178,11 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 			})
 		} else {
 			if expectedType != "string" && expectedType != "any" {
-				return nil, fmt.Errorf("expected string type or AdditionalProperties allowed for path %s, got %v", path, field)
+				return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: "string"}
 			}
 			expressions, err := extractExpressions(field)
 			if err != nil {
-				return nil, err
+				return nil, &CELSyntaxError{Path: path, Expression: field, Pos: -1, Err: err}
 			}
 			if len(expressions) > 0 {
 				expressionsFields = append(expressionsFields, ExpressionField{
@@ -160,7 +199,7 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 		switch expectedType {
 		case "number":
 			if _, ok := field.(float64); !ok {
-				return nil, fmt.Errorf("expected number type for path %s, got %T", path, field)
+				return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: fmt.Sprintf("%T", field)}
 			}
 		case "integer":
 			_, isInt := field.(int)
@@ -168,21 +207,205 @@ func parseResource(resource interface{}, schema *spec.Schema, path string) ([]Ex
 			_, isInt32 := field.(int32)
 
 			if !isInt && !isInt64 && !isInt32 {
-				return nil, fmt.Errorf("expected integer type for path %s, got %T", path, field)
+				return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: fmt.Sprintf("%T", field)}
 			}
 		case "boolean":
 			if _, ok := field.(bool); !ok {
-				return nil, fmt.Errorf("expected boolean type for path %s, got %T", path, field)
+				return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: fmt.Sprintf("%T", field)}
 			}
+		case "string", "object", "array":
+			// The outer type switch already handles these Go types (string,
+			// map[string]interface{}, []interface{}); landing here means the
+			// concrete value's Go type doesn't match what the schema
+			// declared, not that the schema uses an unsupported feature.
+			return nil, &SchemaMismatchError{Path: path, Expected: expectedType, GotType: fmt.Sprintf("%T", field)}
 		default:
-			return nil, fmt.Errorf("unexpected type for path %s: %T", path, field)
+			return nil, &UnsupportedSchemaError{Path: path, Reason: fmt.Sprintf("schema declares unsupported type %q for go value of type %T", expectedType, field)}
 		}
 	}
 
 	return expressionsFields, nil
 }
 
-func getFieldSchema(schema *spec.Schema, field string) (*spec.Schema, error) {
+// parseUnionSchema handles schemas composed of oneOf/anyOf branches. Each
+// branch is tried against the concrete value (and any CEL expressions
+// inferred from it). For anyOf evaluating a concrete (non-CEL) value, the
+// first branch that validates is used to produce the resulting
+// ExpressionFields. For oneOf evaluating a concrete value, every branch is
+// tried so that more than one match can be detected: a concrete value can
+// only conform to a single branch of a oneOf, so matching more than one
+// branch is an error. For a one-shot CEL expression, anyOf and oneOf behave
+// the same: every branch is tried and, if more than one is viable, their
+// results are merged (see below) rather than only the first being used,
+// since tryAllBranches is true for oneShotCEL regardless of isOneOf.
+//
+// When the value is a one-shot CEL expression, its type can't be checked
+// against a concrete branch, so every branch that parses without error is
+// viable. In that case the resulting ExpectedType is a comma-joined union of
+// the viable branches' types, and ExpectedSchema is a oneOf of those
+// branches as-is (see mergeUnionExpressionField) so downstream evaluators can
+// enforce the union as a whole; unlike allOf, union branches are not required
+// to share a type (e.g. IntOrString is oneOf: [{type: string}, {type: integer}]).
+func parseUnionSchema(resource interface{}, schema *spec.Schema, path string) ([]ExpressionField, error) {
+	branches := schema.OneOf
+	isOneOf := len(branches) > 0
+	if !isOneOf {
+		branches = schema.AnyOf
+	}
+
+	oneShotCEL := false
+	if s, ok := resource.(string); ok {
+		cel, err := isOneShotExpression(s)
+		if err != nil {
+			return nil, err
+		}
+		oneShotCEL = cel
+	}
+
+	// oneOf must try every branch even for a concrete value, so that
+	// matching more than one branch can be detected below; anyOf never
+	// requires uniqueness, and stops at the first viable branch.
+	tryAllBranches := oneShotCEL || isOneOf
+
+	var (
+		matches      [][]ExpressionField
+		matchedIdxs  []int
+		branchErrors []string
+	)
+	for i := range branches {
+		branch := branches[i]
+		fields, err := parseResource(resource, &branch, path)
+		if err != nil {
+			branchErrors = append(branchErrors, fmt.Sprintf("branch %d (type %v): %v", i, branch.Type, err))
+			continue
+		}
+		matches = append(matches, fields)
+		matchedIdxs = append(matchedIdxs, i)
+		if !tryAllBranches {
+			break
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, &UnionNoMatchError{Path: path, BranchErrors: branchErrors}
+	}
+
+	if isOneOf && !oneShotCEL && len(matches) > 1 {
+		return nil, &AmbiguousUnionMatchError{Path: path, MatchedBranches: matchedIdxs}
+	}
+
+	if !oneShotCEL || len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	return []ExpressionField{mergeUnionExpressionField(matches, branches, matchedIdxs)}, nil
+}
+
+// mergeUnionExpressionField builds the single ExpressionField reported for a
+// one-shot CEL value that satisfies more than one oneOf/anyOf branch. The
+// branches are preserved as-is in a oneOf schema rather than merged with
+// mergeAllOf, since a union's branches (e.g. IntOrString's string/integer
+// branches) need not share a type the way allOf's intersection requires.
+func mergeUnionExpressionField(matches [][]ExpressionField, branches []spec.Schema, matchedIdxs []int) ExpressionField {
+	types := make([]string, 0, len(matches))
+	seenTypes := map[string]bool{}
+	branchSchemas := make([]spec.Schema, 0, len(matches))
+	for i, fields := range matches {
+		if len(fields) != 1 {
+			continue
+		}
+		if t := fields[0].ExpectedType; !seenTypes[t] {
+			seenTypes[t] = true
+			types = append(types, t)
+		}
+		branchSchemas = append(branchSchemas, branches[matchedIdxs[i]])
+	}
+
+	field := matches[0][0]
+	field.ExpectedType = strings.Join(types, ",")
+	field.ExpectedSchema = &spec.Schema{SchemaProps: spec.SchemaProps{OneOf: branchSchemas}}
+	return field
+}
+
+// mergeAllOf merges the branches of an allOf schema (plus any sibling
+// keywords on schema itself) into a single effective schema: properties are
+// unioned and required fields are combined additively. allOf is an
+// intersection, so type is only narrowed when every branch that declares a
+// type declares the *same* type (branches that omit Type simply don't
+// constrain it further); branches declaring genuinely different types are a
+// contradictory schema and return an error rather than being narrowed, since
+// there is no single type that satisfies both.
+//
+// A branch that is itself a oneOf/anyOf/allOf (e.g. allOf: [{oneOf: [...]},
+// {type: object, ...}]) contributes no Type/Properties/Required of its own
+// to merge — those keywords are mutually exclusive with OneOf/AnyOf/AllOf on
+// a single branch — so it is returned separately as a composite branch
+// instead of being silently dropped. The caller is responsible for
+// re-validating the resource against each composite branch (parseResource
+// does this), since only the concrete value determines which of *its*
+// sub-branches applies.
+func mergeAllOf(schema *spec.Schema, path string) (merged *spec.Schema, compositeBranches []spec.Schema, err error) {
+	merged = &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Properties: map[string]spec.Schema{},
+		},
+	}
+
+	branches := schema.AllOf
+	if len(schema.Type) > 0 || len(schema.Properties) > 0 || len(schema.Required) > 0 ||
+		len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 ||
+		schema.AdditionalProperties != nil || schema.Items != nil {
+		// Sibling keywords alongside allOf act as an implicit extra branch.
+		siblings := schema.SchemaProps
+		siblings.AllOf = nil
+		branches = append([]spec.Schema{{SchemaProps: siblings}}, branches...)
+	}
+
+	for i, branch := range branches {
+		if len(branch.OneOf) > 0 || len(branch.AnyOf) > 0 || len(branch.AllOf) > 0 {
+			compositeBranches = append(compositeBranches, branch)
+		}
+
+		if len(branch.Type) > 1 {
+			return nil, nil, &UnsupportedSchemaError{Path: path, Reason: fmt.Sprintf("allOf branch %d declares more than one type: %v", i, branch.Type)}
+		}
+		if len(branch.Type) == 1 {
+			if len(merged.Type) == 0 {
+				merged.Type = []string{branch.Type[0]}
+			} else if merged.Type[0] != branch.Type[0] {
+				return nil, nil, &AllOfConflictError{Path: path, TypeA: merged.Type[0], TypeB: branch.Type[0]}
+			}
+		}
+		for name, propSchema := range branch.Properties {
+			merged.Properties[name] = propSchema
+		}
+		merged.Required = append(merged.Required, branch.Required...)
+		if branch.AdditionalProperties != nil {
+			merged.AdditionalProperties = branch.AdditionalProperties
+		}
+		if branch.Items != nil {
+			merged.Items = branch.Items
+		}
+	}
+
+	if len(merged.Type) == 0 {
+		return nil, nil, &UnsupportedSchemaError{Path: path, Reason: "allOf did not resolve to a single type"}
+	}
+
+	seen := map[string]bool{}
+	dedupedRequired := merged.Required[:0]
+	for _, r := range merged.Required {
+		if !seen[r] {
+			seen[r] = true
+			dedupedRequired = append(dedupedRequired, r)
+		}
+	}
+	merged.Required = dedupedRequired
+
+	return merged, compositeBranches, nil
+}
+
+func getFieldSchema(schema *spec.Schema, fieldPath, field string) (*spec.Schema, error) {
 	if schema.Properties != nil {
 		if fieldSchema, ok := schema.Properties[field]; ok {
 			return &fieldSchema, nil
@@ -199,5 +422,5 @@ func getFieldSchema(schema *spec.Schema, field string) (*spec.Schema, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("schema not found for field %s", field)
+	return nil, &UnknownFieldError{Path: fieldPath, Field: field}
 }
\ No newline at end of file
@@ -0,0 +1,330 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func stringSchema() spec.Schema {
+	return spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"string"}}}
+}
+
+func integerSchema() spec.Schema {
+	return spec.Schema{SchemaProps: spec.SchemaProps{Type: []string{"integer"}}}
+}
+
+func TestParseResourceOneOfUniqueMatch(t *testing.T) {
+	// A oneOf where only the object branch can possibly match a map value.
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			OneOf: []spec.Schema{
+				stringSchema(),
+				{
+					SchemaProps: spec.SchemaProps{
+						Type:       []string{"object"},
+						Properties: map[string]spec.Schema{"name": stringSchema()},
+					},
+				},
+			},
+		},
+	}
+
+	resource := map[string]interface{}{"name": "bucket"}
+	fields, err := parseResource(resource, schema, "spec.target")
+	if err != nil {
+		t.Fatalf("parseResource: %v", err)
+	}
+	if len(fields) != 0 {
+		t.Fatalf("expected no expression fields for a plain value, got %v", fields)
+	}
+}
+
+func TestParseResourceOneOfMultipleMatchesIsError(t *testing.T) {
+	// Two branches that both accept any string: a concrete string value
+	// matches both, which oneOf forbids.
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			OneOf: []spec.Schema{
+				stringSchema(),
+				stringSchema(),
+			},
+		},
+	}
+
+	_, err := parseResource("hello", schema, "spec.value")
+	if err == nil {
+		t.Fatal("expected an error when a value matches more than one oneOf branch")
+	}
+
+	var ambiguous *AmbiguousUnionMatchError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected a *AmbiguousUnionMatchError, got %T: %v", err, err)
+	}
+	if ambiguous.Path != "spec.value" || len(ambiguous.MatchedBranches) != 2 {
+		t.Fatalf("unexpected AmbiguousUnionMatchError: %+v", ambiguous)
+	}
+}
+
+func TestParseResourceAnyOfFirstMatch(t *testing.T) {
+	// anyOf stops at the first viable branch rather than requiring
+	// uniqueness, so two string branches are not an error.
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AnyOf: []spec.Schema{
+				stringSchema(),
+				stringSchema(),
+			},
+		},
+	}
+
+	fields, err := parseResource("hello", schema, "spec.value")
+	if err != nil {
+		t.Fatalf("parseResource: %v", err)
+	}
+	if len(fields) != 0 {
+		t.Fatalf("expected no expression fields for a plain value, got %v", fields)
+	}
+}
+
+func TestParseResourceOneOfIntOrStringOneShotCEL(t *testing.T) {
+	// The canonical case this request was written for: a oneOf of
+	// string/integer branches (IntOrString) evaluated against a one-shot
+	// CEL expression, which can satisfy either branch.
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			OneOf: []spec.Schema{
+				stringSchema(),
+				integerSchema(),
+			},
+		},
+	}
+
+	fields, err := parseResource("${foo.replicas}", schema, "spec.replicas")
+	if err != nil {
+		t.Fatalf("parseResource: %v", err)
+	}
+	if len(fields) != 1 {
+		t.Fatalf("expected exactly one merged expression field, got %d: %v", len(fields), fields)
+	}
+
+	field := fields[0]
+	if field.ExpectedType != "string,integer" {
+		t.Fatalf("expected merged type %q, got %q", "string,integer", field.ExpectedType)
+	}
+	if field.ExpectedSchema == nil || len(field.ExpectedSchema.OneOf) != 2 {
+		t.Fatalf("expected a oneOf of both branches, got %v", field.ExpectedSchema)
+	}
+	if !field.OneShotCEL {
+		t.Fatal("expected OneShotCEL to be true")
+	}
+}
+
+func TestMergeAllOfPropertiesAndRequired(t *testing.T) {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AllOf: []spec.Schema{
+				{
+					SchemaProps: spec.SchemaProps{
+						Type:       []string{"object"},
+						Properties: map[string]spec.Schema{"name": stringSchema()},
+						Required:   []string{"name"},
+					},
+				},
+				{
+					SchemaProps: spec.SchemaProps{
+						Properties: map[string]spec.Schema{"replicas": integerSchema()},
+						Required:   []string{"replicas"},
+					},
+				},
+			},
+		},
+	}
+
+	merged, compositeBranches, err := mergeAllOf(schema, "spec.target")
+	if err != nil {
+		t.Fatalf("mergeAllOf: %v", err)
+	}
+	if len(compositeBranches) != 0 {
+		t.Fatalf("expected no composite branches, got %v", compositeBranches)
+	}
+	if len(merged.Type) != 1 || merged.Type[0] != "object" {
+		t.Fatalf("expected merged type [object], got %v", merged.Type)
+	}
+	if _, ok := merged.Properties["name"]; !ok {
+		t.Fatal("expected merged properties to include \"name\"")
+	}
+	if _, ok := merged.Properties["replicas"]; !ok {
+		t.Fatal("expected merged properties to include \"replicas\"")
+	}
+	wantRequired := map[string]bool{"name": true, "replicas": true}
+	if len(merged.Required) != len(wantRequired) {
+		t.Fatalf("expected required %v, got %v", wantRequired, merged.Required)
+	}
+	for _, r := range merged.Required {
+		if !wantRequired[r] {
+			t.Fatalf("unexpected required field %q", r)
+		}
+	}
+}
+
+func TestMergeAllOfConflictingTypesIsError(t *testing.T) {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AllOf: []spec.Schema{
+				stringSchema(),
+				integerSchema(),
+			},
+		},
+	}
+
+	_, _, err := mergeAllOf(schema, "spec.target")
+	if err == nil {
+		t.Fatal("expected an error for allOf branches declaring conflicting types")
+	}
+
+	var conflict *AllOfConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected a *AllOfConflictError, got %T: %v", err, err)
+	}
+	if conflict.TypeA != "string" || conflict.TypeB != "integer" {
+		t.Fatalf("unexpected AllOfConflictError: %+v", conflict)
+	}
+}
+
+func TestParseResourceAllOfMergedViaParseResource(t *testing.T) {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AllOf: []spec.Schema{
+				{
+					SchemaProps: spec.SchemaProps{
+						Type:       []string{"object"},
+						Properties: map[string]spec.Schema{"name": stringSchema()},
+					},
+				},
+				{
+					SchemaProps: spec.SchemaProps{
+						Properties: map[string]spec.Schema{"image": stringSchema()},
+					},
+				},
+			},
+		},
+	}
+
+	resource := map[string]interface{}{"name": "${foo.name}", "image": "nginx"}
+	fields, err := parseResource(resource, schema, "spec.container")
+	if err != nil {
+		t.Fatalf("parseResource: %v", err)
+	}
+	if len(fields) != 1 || fields[0].Path != "spec.container.name" {
+		t.Fatalf("expected a single expression field at spec.container.name, got %v", fields)
+	}
+}
+
+func TestParseResourceAllOfNestedOneOfBranchRejectsAmbiguousMatch(t *testing.T) {
+	// A branch that is itself a oneOf (of two indistinguishable string
+	// branches) can't be merged into the flat property/type bag mergeAllOf
+	// builds for the other branches; it has to be re-validated on its own so
+	// its oneOf-uniqueness constraint is still enforced.
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AllOf: []spec.Schema{
+				{SchemaProps: spec.SchemaProps{OneOf: []spec.Schema{stringSchema(), stringSchema()}}},
+				stringSchema(),
+			},
+		},
+	}
+
+	_, err := parseResource("hello", schema, "spec.value")
+	if err == nil {
+		t.Fatal("expected an error: the nested oneOf branch matches both its alternatives")
+	}
+	var ambiguous *AmbiguousUnionMatchError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected a *AmbiguousUnionMatchError, got %T: %v", err, err)
+	}
+}
+
+func TestParseResourceAllOfNestedOneOfBranchAccepted(t *testing.T) {
+	// Same shape, but the nested oneOf's branches are string and integer,
+	// and the value is a one-shot CEL expression: both branches accept it,
+	// and oneOf uniqueness is only enforced for concrete values, so the
+	// allOf as a whole is valid; the outer allOf's own "string" branch
+	// still drives the reported ExpectedType.
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			AllOf: []spec.Schema{
+				{SchemaProps: spec.SchemaProps{OneOf: []spec.Schema{stringSchema(), integerSchema()}}},
+				stringSchema(),
+			},
+		},
+	}
+
+	fields, err := parseResource("${foo.name}", schema, "spec.value")
+	if err != nil {
+		t.Fatalf("parseResource: %v", err)
+	}
+	if len(fields) != 1 || fields[0].ExpectedType != "string" {
+		t.Fatalf("expected a single string-typed expression field, got %v", fields)
+	}
+}
+
+func TestParseResourceTypeMismatchIsSchemaMismatchNotUnsupported(t *testing.T) {
+	// A float64 value against a "string" schema falls through the outer
+	// type switch into the default arm; it must be reported as a schema
+	// mismatch, not as an unsupported schema feature.
+	_, err := parseResource(float64(3), stringSchemaPtr(), "spec.name")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	var mismatch *SchemaMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected a *SchemaMismatchError, got %T: %v", err, err)
+	}
+	if mismatch.Expected != "string" || mismatch.GotType != "float64" {
+		t.Fatalf("unexpected SchemaMismatchError: %+v", mismatch)
+	}
+}
+
+func stringSchemaPtr() *spec.Schema {
+	s := stringSchema()
+	return &s
+}
+
+func TestParseUnionSchemaNoMatchingBranch(t *testing.T) {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			OneOf: []spec.Schema{
+				integerSchema(),
+			},
+		},
+	}
+
+	_, err := parseResource("not-a-number", schema, "spec.value")
+	if err == nil {
+		t.Fatal("expected an error when no branch matches")
+	}
+
+	var noMatch *UnionNoMatchError
+	if !errors.As(err, &noMatch) {
+		t.Fatalf("expected a *UnionNoMatchError, got %T: %v", err, err)
+	}
+	if noMatch.Path != "spec.value" || len(noMatch.BranchErrors) != 1 {
+		t.Fatalf("unexpected UnionNoMatchError: %+v", noMatch)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+// Annotations recognized on both a ResourceGroup's resource definitions and
+// on instance objects to customize drift detection and patch strategy for a
+// resource, mirroring the per-field diff-customization annotations common to
+// GitOps engines.
+const (
+	// AnnotationCompareOptions selects a non-default drift comparison
+	// strategy for the whole resource, e.g. "IgnoreExtraneous" to not
+	// consider fields added by the cluster or other controllers as drift.
+	AnnotationCompareOptions = "kro.run/compare-options"
+	// AnnotationSyncOptions selects how the resource is patched during
+	// reconciliation, e.g. "ServerSideApply=true".
+	AnnotationSyncOptions = "kro.run/sync-options"
+	// AnnotationIgnoreDiff is a comma-separated list of JSONPath-like field
+	// paths (a path segment may be "[*]" to match every array element) that
+	// should never be considered drifted, regardless of ComparePolicy.
+	AnnotationIgnoreDiff = "kro.run/ignore-diff"
+
+	// extensionIgnoreDiff is the x-kubernetes-* schema extension equivalent
+	// of AnnotationIgnoreDiff: set directly on a field's schema (typically
+	// because the field is known to be mutated by another controller, e.g.
+	// HPA owning spec.replicas) rather than as an annotation on the resource.
+	extensionIgnoreDiff = "x-kubernetes-ignore-diff"
+
+	compareOptionIgnoreExtraneous = "IgnoreExtraneous"
+	syncOptionServerSideApply     = "ServerSideApply"
+)
+
+// ComparePolicy describes how drift detection and patching should treat a
+// resource as a whole: whether fields added by the cluster or other
+// controllers beyond what the ResourceGroup defines should be considered
+// drift, and whether the resource should be patched with a three-way merge
+// (the default) or server-side apply. kro.run/compare-options and
+// kro.run/sync-options apply to the entire resource, not a subtree of it;
+// per-field scoping within a resource is what IgnorePaths is for.
+//
+// Follow-up (tracked, not done here): wire ComparePolicy/IgnorePaths into a
+// patch path so they're actually honored. Nothing in this checkout reads
+// them yet -- they are extracted and returned but consumed nowhere.
+// setResourceGroupStatus (internal/controller/resourcegroup_status.go) is
+// not the right place to wire them in: it only patches the ResourceGroup
+// object's own status subresource, not a resource the graph manages, so
+// IgnorePaths/ComparePolicy (which describe drift on a managed resource)
+// wouldn't mean anything there. The instance reconciler that would apply
+// these per managed resource lives outside this checkout.
+type ComparePolicy struct {
+	// IgnoreExtraneous skips drift caused by fields present in the live
+	// object but not specified in the ResourceGroup definition.
+	IgnoreExtraneous bool
+	// ServerSideApply, when true, patches this resource with server-side
+	// apply instead of a three-way merge.
+	ServerSideApply bool
+}
+
+// ExtractDriftPolicies reads the kro.run/compare-options, kro.run/sync-options
+// and kro.run/ignore-diff annotations off resource's metadata, plus any
+// x-kubernetes-ignore-diff extension reachable from resourceSchema, and
+// returns the resulting ignore paths and compare policy. Both resource and
+// resourceSchema may be the ResourceGroup's definition or a live instance
+// object; the same annotations are recognized on either.
+//
+// Unlike ParseResource, this does not fail the whole resource on a bad
+// annotation value: an unparsable sync-options/compare-options value is
+// dropped with its policy left at the default instead of blocking
+// reconciliation over a typo in an escape hatch.
+func ExtractDriftPolicies(resource map[string]interface{}, resourceSchema *spec.Schema) ([]string, ComparePolicy, error) {
+	annotations := metadataAnnotations(resource)
+
+	policy := ComparePolicy{}
+	if raw, ok := annotations[AnnotationCompareOptions]; ok {
+		for _, opt := range strings.Split(raw, ",") {
+			if strings.TrimSpace(opt) == compareOptionIgnoreExtraneous {
+				policy.IgnoreExtraneous = true
+			}
+		}
+	}
+	if raw, ok := annotations[AnnotationSyncOptions]; ok {
+		for _, opt := range strings.Split(raw, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+			if name != syncOptionServerSideApply {
+				continue
+			}
+			if enabled, err := strconv.ParseBool(value); err == nil {
+				policy.ServerSideApply = enabled
+			}
+		}
+	}
+
+	var ignorePaths []string
+	if raw, ok := annotations[AnnotationIgnoreDiff]; ok {
+		for _, p := range strings.Split(raw, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				ignorePaths = append(ignorePaths, p)
+			}
+		}
+	}
+	ignorePaths = append(ignorePaths, ignoreDiffPathsFromSchema(resourceSchema, "")...)
+
+	return ignorePaths, policy, nil
+}
+
+// ignoreDiffPathsFromSchema walks schema collecting the path of every field
+// whose schema carries the x-kubernetes-ignore-diff extension. It walks the
+// schema alone rather than the resource, since an ignored field may not be
+// present in every instance of the resource. It descends only into
+// Properties and array Items; allOf/oneOf/anyOf branches and
+// additionalProperties schemas are not searched.
+//
+// Paths are joined with joinPath rather than a bare "path+\".\"+name" so
+// that, like the paths parsed out of AnnotationIgnoreDiff, they never carry
+// a leading dot at the root (e.g. "spec.replicas", not ".spec.replicas").
+func ignoreDiffPathsFromSchema(schema *spec.Schema, path string) []string {
+	if schema == nil {
+		return nil
+	}
+
+	var paths []string
+	if ignore, ok := schema.Extensions.GetBool(extensionIgnoreDiff); ok && ignore {
+		paths = append(paths, path)
+	}
+
+	for name, propSchema := range schema.Properties {
+		propSchema := propSchema
+		paths = append(paths, ignoreDiffPathsFromSchema(&propSchema, joinPath(path, name))...)
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		paths = append(paths, ignoreDiffPathsFromSchema(schema.Items.Schema, path+"[*]")...)
+	}
+
+	return paths
+}
+
+// joinPath appends name to path as a "." separated JSONPath-like path,
+// without a leading dot when path is the root ("").
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// metadataAnnotations extracts resource["metadata"]["annotations"] as a
+// string map, returning an empty map if the resource has no annotations.
+func metadataAnnotations(resource map[string]interface{}) map[string]string {
+	annotations := map[string]string{}
+
+	metadata, ok := resource["metadata"].(map[string]interface{})
+	if !ok {
+		return annotations
+	}
+	raw, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		return annotations
+	}
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			annotations[k] = s
+		}
+	}
+	return annotations
+}
@@ -0,0 +1,178 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package parser
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func resourceWithAnnotations(annotations map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	}
+}
+
+func TestMetadataAnnotations(t *testing.T) {
+	cases := map[string]struct {
+		resource map[string]interface{}
+		want     map[string]string
+	}{
+		"no metadata": {
+			resource: map[string]interface{}{},
+			want:     map[string]string{},
+		},
+		"no annotations": {
+			resource: map[string]interface{}{"metadata": map[string]interface{}{}},
+			want:     map[string]string{},
+		},
+		"string annotations only": {
+			resource: resourceWithAnnotations(map[string]interface{}{
+				"kro.run/sync-options": "ServerSideApply=true",
+				"non-string":           42,
+			}),
+			want: map[string]string{"kro.run/sync-options": "ServerSideApply=true"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := metadataAnnotations(tc.resource)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("metadataAnnotations() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreDiffPathsFromSchema(t *testing.T) {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type: []string{"object"},
+			Properties: map[string]spec.Schema{
+				"spec": {
+					SchemaProps: spec.SchemaProps{
+						Type: []string{"object"},
+						Properties: map[string]spec.Schema{
+							"replicas": {
+								SchemaProps: spec.SchemaProps{Type: []string{"integer"}},
+								VendorExtensible: spec.VendorExtensible{
+									Extensions: spec.Extensions{"x-kubernetes-ignore-diff": true},
+								},
+							},
+							"containers": {
+								SchemaProps: spec.SchemaProps{
+									Type: []string{"array"},
+									Items: &spec.SchemaOrArray{
+										Schema: &spec.Schema{
+											SchemaProps: spec.SchemaProps{
+												Type: []string{"object"},
+												Properties: map[string]spec.Schema{
+													"image": {
+														SchemaProps: spec.SchemaProps{Type: []string{"string"}},
+														VendorExtensible: spec.VendorExtensible{
+															Extensions: spec.Extensions{"x-kubernetes-ignore-diff": true},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := ignoreDiffPathsFromSchema(schema, "")
+	sort.Strings(got)
+	want := []string{"spec.containers[*].image", "spec.replicas"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ignoreDiffPathsFromSchema() = %v, want %v", got, want)
+	}
+}
+
+func TestExtractDriftPoliciesFromAnnotations(t *testing.T) {
+	resource := resourceWithAnnotations(map[string]interface{}{
+		AnnotationCompareOptions: "IgnoreExtraneous",
+		AnnotationSyncOptions:    "ServerSideApply=true",
+		AnnotationIgnoreDiff:     "spec.replicas, spec.template.spec.containers[*].image",
+	})
+
+	ignorePaths, policy, err := ExtractDriftPolicies(resource, nil)
+	if err != nil {
+		t.Fatalf("ExtractDriftPolicies: %v", err)
+	}
+
+	wantPolicy := ComparePolicy{IgnoreExtraneous: true, ServerSideApply: true}
+	if policy != wantPolicy {
+		t.Fatalf("ComparePolicy = %+v, want %+v", policy, wantPolicy)
+	}
+
+	wantPaths := []string{"spec.replicas", "spec.template.spec.containers[*].image"}
+	if !reflect.DeepEqual(ignorePaths, wantPaths) {
+		t.Fatalf("ignorePaths = %v, want %v", ignorePaths, wantPaths)
+	}
+}
+
+func TestExtractDriftPoliciesDefaultsOnUnparsableSyncOptions(t *testing.T) {
+	resource := resourceWithAnnotations(map[string]interface{}{
+		AnnotationSyncOptions: "ServerSideApply=not-a-bool",
+	})
+
+	_, policy, err := ExtractDriftPolicies(resource, nil)
+	if err != nil {
+		t.Fatalf("ExtractDriftPolicies: %v", err)
+	}
+	if policy.ServerSideApply {
+		t.Fatalf("expected ServerSideApply to default to false on an unparsable value, got %+v", policy)
+	}
+}
+
+func TestExtractDriftPoliciesMergesAnnotationAndSchemaIgnorePaths(t *testing.T) {
+	resource := resourceWithAnnotations(map[string]interface{}{
+		AnnotationIgnoreDiff: "spec.replicas",
+	})
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type: []string{"object"},
+			Properties: map[string]spec.Schema{
+				"status": {
+					SchemaProps: spec.SchemaProps{Type: []string{"string"}},
+					VendorExtensible: spec.VendorExtensible{
+						Extensions: spec.Extensions{"x-kubernetes-ignore-diff": true},
+					},
+				},
+			},
+		},
+	}
+
+	ignorePaths, _, err := ExtractDriftPolicies(resource, schema)
+	if err != nil {
+		t.Fatalf("ExtractDriftPolicies: %v", err)
+	}
+
+	want := []string{"spec.replicas", "status"}
+	if !reflect.DeepEqual(ignorePaths, want) {
+		t.Fatalf("ignorePaths = %v, want %v", ignorePaths, want)
+	}
+}